@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestCallerDirectCall verifies a direct log call resolves the caller field
+// to the actual call site, with WithShortCaller trimming it to the base
+// filename.
+func TestCallerDirectCall(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(WithOutput(&buf), WithLogFormat(LogFormatJSON), WithShortCaller(true))
+
+	_, file, line, ok := runtime.Caller(0)
+	log.Info("direct call")
+	if !ok {
+		t.Fatal("runtime.Caller(0) failed")
+	}
+	want := fmt.Sprintf("%s:%d", filepath.Base(file), line+1)
+
+	if !strings.Contains(buf.String(), `"caller":"`+want+`"`) {
+		t.Errorf("output missing caller %q: %s", want, buf.String())
+	}
+}
+
+// TestCallerFullPathByDefault verifies the caller field carries the full
+// file path when WithShortCaller isn't set.
+func TestCallerFullPathByDefault(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(WithOutput(&buf), WithLogFormat(LogFormatJSON))
+
+	_, file, line, ok := runtime.Caller(0)
+	log.Info("full path")
+	if !ok {
+		t.Fatal("runtime.Caller(0) failed")
+	}
+	want := fmt.Sprintf("%s:%d", file, line+1)
+
+	if !strings.Contains(buf.String(), `"caller":"`+want+`"`) {
+		t.Errorf("output missing full-path caller %q: %s", want, buf.String())
+	}
+}
+
+// logViaWrapper stands in for a package that wraps ZeroLogger behind its own
+// logging helper, adding one stack frame between the real call site and
+// Info.
+func logViaWrapper(log Logger, msg string) {
+	log.Info(msg)
+}
+
+// TestCallerSkipFramesWrapper verifies CallerSkipFrames(1) compensates for
+// one wrapper frame, so the caller field still resolves to the test's own
+// call site rather than logViaWrapper.
+func TestCallerSkipFramesWrapper(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(WithOutput(&buf), WithLogFormat(LogFormatJSON), WithShortCaller(true))
+	wrapped := log.CallerSkipFrames(1)
+
+	_, file, line, ok := runtime.Caller(0)
+	logViaWrapper(wrapped, "via wrapper")
+	if !ok {
+		t.Fatal("runtime.Caller(0) failed")
+	}
+	want := fmt.Sprintf("%s:%d", filepath.Base(file), line+1)
+
+	if !strings.Contains(buf.String(), `"caller":"`+want+`"`) {
+		t.Errorf("output missing caller %q (wrapper frame not skipped): %s", want, buf.String())
+	}
+}
+
+// TestWithAddCallerFalseSuppressesFields verifies WithAddCaller(false) omits
+// both the caller and func fields entirely.
+func TestWithAddCallerFalseSuppressesFields(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(WithOutput(&buf), WithLogFormat(LogFormatJSON), WithAddCaller(false))
+
+	log.Info("no caller")
+
+	out := buf.String()
+	if strings.Contains(out, `"caller"`) || strings.Contains(out, `"func"`) {
+		t.Errorf("output contains caller/func fields with WithAddCaller(false): %s", out)
+	}
+}
@@ -0,0 +1,45 @@
+package logger
+
+import "context"
+
+// ctxFieldsKey is the context.Context key under which ContextWithFields
+// stores structured fields for FromContext to pick up.
+type ctxFieldsKey struct{}
+
+// ContextWithFields returns a copy of ctx carrying additional structured
+// fields (accepted in the same key-value form as WithFields) for FromContext
+// to merge into every log line it emits. Fields attached this way compose
+// with any already present on ctx, with later calls overriding earlier ones
+// on key collision.
+func ContextWithFields(ctx context.Context, fields ...any) context.Context {
+	merged := FieldsFromContext(ctx)
+	for k, v := range convertFields(fields...) {
+		merged[k] = v
+	}
+
+	return context.WithValue(ctx, ctxFieldsKey{}, merged)
+}
+
+// FieldsFromContext returns the structured fields previously attached to ctx
+// via ContextWithFields, or an empty map if none are present.
+func FieldsFromContext(ctx context.Context) map[string]any {
+	existing, _ := ctx.Value(ctxFieldsKey{}).(map[string]any)
+
+	fields := make(map[string]any, len(existing))
+	for k, v := range existing {
+		fields[k] = v
+	}
+
+	return fields
+}
+
+// WithContextExtractor registers a function that FromContext consults to
+// pull additional fields out of a context.Context (e.g. an OpenTelemetry
+// span), on top of anything attached via ContextWithFields. Applications
+// can use this to plug in their own context-to-fields convention without
+// this package needing to know about it.
+func WithContextExtractor(fn func(context.Context) map[string]any) option {
+	return func(c *config) {
+		c.extractor = fn
+	}
+}
@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestContextWithFieldsMerges verifies fields attached across multiple
+// ContextWithFields calls accumulate, with later keys overriding earlier ones.
+func TestContextWithFieldsMerges(t *testing.T) {
+	ctx := ContextWithFields(context.Background(), "a", 1)
+	ctx = ContextWithFields(ctx, "b", 2, "a", 3)
+
+	fields := FieldsFromContext(ctx)
+	if fields["a"] != 3 {
+		t.Errorf("fields[a] = %v, want 3 (later call should override)", fields["a"])
+	}
+	if fields["b"] != 2 {
+		t.Errorf("fields[b] = %v, want 2", fields["b"])
+	}
+}
+
+// TestFieldsFromContextEmpty verifies a context with no attached fields
+// yields an empty, non-nil map.
+func TestFieldsFromContextEmpty(t *testing.T) {
+	fields := FieldsFromContext(context.Background())
+	if fields == nil {
+		t.Fatalf("FieldsFromContext returned nil, want empty map")
+	}
+	if len(fields) != 0 {
+		t.Errorf("FieldsFromContext = %v, want empty", fields)
+	}
+}
+
+// TestFieldsFromContextReturnsCopy verifies mutating the returned map never
+// affects what a later FieldsFromContext call sees.
+func TestFieldsFromContextReturnsCopy(t *testing.T) {
+	ctx := ContextWithFields(context.Background(), "a", 1)
+
+	fields := FieldsFromContext(ctx)
+	fields["a"] = 999
+
+	again := FieldsFromContext(ctx)
+	if again["a"] != 1 {
+		t.Errorf("fields[a] = %v after mutating a prior copy, want 1", again["a"])
+	}
+}
+
+// TestFromContextAppliesContextFieldsAndExtractor verifies FromContext merges
+// both ContextWithFields and a configured WithContextExtractor onto the
+// emitted record.
+func TestFromContextAppliesContextFieldsAndExtractor(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(
+		WithOutput(&buf),
+		WithLogFormat(LogFormatJSON),
+		WithContextExtractor(func(ctx context.Context) map[string]any {
+			return map[string]any{"extracted": "yes"}
+		}),
+	)
+
+	ctx := ContextWithFields(context.Background(), "request_id", "abc123")
+	log.FromContext(ctx).Info("handled request")
+
+	out := buf.String()
+	if !strings.Contains(out, `"request_id":"abc123"`) {
+		t.Errorf("output missing context field: %s", out)
+	}
+	if !strings.Contains(out, `"extracted":"yes"`) {
+		t.Errorf("output missing extractor field: %s", out)
+	}
+}
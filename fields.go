@@ -0,0 +1,205 @@
+package logger
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// fieldType identifies which of Field's value slots holds the field's data.
+type fieldType int
+
+const (
+	fieldTypeString fieldType = iota
+	fieldTypeInt
+	fieldTypeInt64
+	fieldTypeFloat64
+	fieldTypeBool
+	fieldTypeErr
+	fieldTypeDuration
+	fieldTypeTime
+	fieldTypeStringer
+	fieldTypeAny
+	fieldTypeStack
+)
+
+// Field is a single structured log attribute built by String, Int, Int64,
+// Float64, Bool, Err, Duration, Time, Stringer, Any, or Stack. Passed to
+// the *Fields logging methods (e.g. InfoFields), a Field binds directly
+// onto a zerolog.Event, avoiding the map[string]any that convertFields
+// allocates for the variadic ...any API.
+type Field struct {
+	key   string
+	typ   fieldType
+	str   string
+	num   int64
+	float float64
+	boo   bool
+	dur   time.Duration
+	time  time.Time
+	err   error
+	any   any
+}
+
+// String creates a string-valued Field.
+func String(key, value string) Field {
+	return Field{key: key, typ: fieldTypeString, str: value}
+}
+
+// Int creates an int-valued Field.
+func Int(key string, value int) Field {
+	return Field{key: key, typ: fieldTypeInt, num: int64(value)}
+}
+
+// Int64 creates an int64-valued Field.
+func Int64(key string, value int64) Field {
+	return Field{key: key, typ: fieldTypeInt64, num: value}
+}
+
+// Float64 creates a float64-valued Field.
+func Float64(key string, value float64) Field {
+	return Field{key: key, typ: fieldTypeFloat64, float: value}
+}
+
+// Bool creates a bool-valued Field.
+func Bool(key string, value bool) Field {
+	return Field{key: key, typ: fieldTypeBool, boo: value}
+}
+
+// Err creates a Field for err under the conventional "error" key, matching
+// ZeroLogger.Error/Fatal/Panic's own Err(err) handling.
+func Err(err error) Field {
+	return Field{key: "error", typ: fieldTypeErr, err: err}
+}
+
+// Duration creates a Field holding a time.Duration.
+func Duration(key string, value time.Duration) Field {
+	return Field{key: key, typ: fieldTypeDuration, dur: value}
+}
+
+// Time creates a Field holding a time.Time.
+func Time(key string, value time.Time) Field {
+	return Field{key: key, typ: fieldTypeTime, time: value}
+}
+
+// Stringer creates a Field whose value is rendered via value.String().
+func Stringer(key string, value fmt.Stringer) Field {
+	return Field{key: key, typ: fieldTypeStringer, any: value}
+}
+
+// Any creates a Field holding an arbitrary value, encoded the way zerolog's
+// Event.Interface would. Prefer a typed constructor when one fits.
+func Any(key string, value any) Field {
+	return Field{key: key, typ: fieldTypeAny, any: value}
+}
+
+// Stack attaches the current goroutine's stack trace, as captured by
+// runtime/debug.Stack when the field is applied, under zerolog's
+// conventional "stack" key.
+func Stack() Field {
+	return Field{typ: fieldTypeStack}
+}
+
+// apply binds the field onto ev.
+func (f Field) apply(ev *zerolog.Event) {
+	switch f.typ {
+	case fieldTypeString:
+		ev.Str(f.key, f.str)
+	case fieldTypeInt:
+		ev.Int(f.key, int(f.num))
+	case fieldTypeInt64:
+		ev.Int64(f.key, f.num)
+	case fieldTypeFloat64:
+		ev.Float64(f.key, f.float)
+	case fieldTypeBool:
+		ev.Bool(f.key, f.boo)
+	case fieldTypeErr:
+		ev.AnErr(f.key, f.err)
+	case fieldTypeDuration:
+		ev.Dur(f.key, f.dur)
+	case fieldTypeTime:
+		ev.Time(f.key, f.time)
+	case fieldTypeStringer:
+		if s, ok := f.any.(fmt.Stringer); ok && s != nil {
+			ev.Str(f.key, s.String())
+		}
+	case fieldTypeAny:
+		ev.Interface(f.key, f.any)
+	case fieldTypeStack:
+		ev.Str(zerolog.ErrorStackFieldName, string(debug.Stack()))
+	}
+}
+
+// TraceFields logs a trace-level message using typed Fields.
+func (z *ZeroLogger) TraceFields(msg string, fields ...Field) {
+	ev := z.snapshot().Trace()
+	z.callerEvent(ev)
+	for _, f := range fields {
+		f.apply(ev)
+	}
+	ev.Msg(msg)
+}
+
+// DebugFields logs a debug-level message using typed Fields.
+func (z *ZeroLogger) DebugFields(msg string, fields ...Field) {
+	ev := z.snapshot().Debug()
+	z.callerEvent(ev)
+	for _, f := range fields {
+		f.apply(ev)
+	}
+	ev.Msg(msg)
+}
+
+// InfoFields logs an info-level message using typed Fields.
+func (z *ZeroLogger) InfoFields(msg string, fields ...Field) {
+	ev := z.snapshot().Info()
+	z.callerEvent(ev)
+	for _, f := range fields {
+		f.apply(ev)
+	}
+	ev.Msg(msg)
+}
+
+// WarnFields logs a warn-level message using typed Fields.
+func (z *ZeroLogger) WarnFields(msg string, fields ...Field) {
+	ev := z.snapshot().Warn()
+	z.callerEvent(ev)
+	for _, f := range fields {
+		f.apply(ev)
+	}
+	ev.Msg(msg)
+}
+
+// ErrorFields logs an error-level message with an error, using typed Fields.
+func (z *ZeroLogger) ErrorFields(err error, msg string, fields ...Field) {
+	ev := z.snapshot().Error().Err(err)
+	z.callerEvent(ev)
+	for _, f := range fields {
+		f.apply(ev)
+	}
+	ev.Msg(msg)
+}
+
+// FatalFields logs a fatal-level message with an error, using typed Fields,
+// then exits the program.
+func (z *ZeroLogger) FatalFields(err error, msg string, fields ...Field) {
+	ev := z.snapshot().Fatal().Err(err)
+	z.callerEvent(ev)
+	for _, f := range fields {
+		f.apply(ev)
+	}
+	ev.Msg(msg)
+}
+
+// PanicFields logs a panic-level message with an error, using typed Fields,
+// then panics.
+func (z *ZeroLogger) PanicFields(err error, msg string, fields ...Field) {
+	ev := z.snapshot().Panic().Err(err)
+	z.callerEvent(ev)
+	for _, f := range fields {
+		f.apply(ev)
+	}
+	ev.Msg(msg)
+}
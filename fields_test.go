@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestInfoFieldsEncodesTypedFields verifies each typed Field constructor
+// lands on the emitted record under its own key.
+func TestInfoFieldsEncodesTypedFields(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(WithOutput(&buf), WithLogFormat(LogFormatJSON))
+
+	log.InfoFields("request handled",
+		String("method", "GET"),
+		Int("status", 200),
+		Int64("bytes", int64(1024)),
+		Float64("duration_ms", 12.5),
+		Bool("cached", true),
+		Duration("latency", 250*time.Millisecond),
+	)
+
+	out := buf.String()
+	for _, want := range []string{
+		`"method":"GET"`,
+		`"status":200`,
+		`"bytes":1024`,
+		`"duration_ms":12.5`,
+		`"cached":true`,
+		`"latency":250`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %s: %s", want, out)
+		}
+	}
+}
+
+// TestErrorFieldsAttachesErr verifies ErrorFields attaches err under
+// zerolog's conventional "error" key, matching Err's own behavior.
+func TestErrorFieldsAttachesErr(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(WithOutput(&buf), WithLogFormat(LogFormatJSON))
+
+	log.ErrorFields(errors.New("boom"), "operation failed")
+
+	if !strings.Contains(buf.String(), `"error":"boom"`) {
+		t.Errorf("output missing error field: %s", buf.String())
+	}
+}
+
+// TestFieldErrConstructor verifies the standalone Err constructor binds onto
+// the same "error" key when passed to a *Fields method.
+func TestFieldErrConstructor(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(WithOutput(&buf), WithLogFormat(LogFormatJSON))
+
+	log.InfoFields("retrying", Err(errors.New("transient")))
+
+	if !strings.Contains(buf.String(), `"error":"transient"`) {
+		t.Errorf("output missing error field: %s", buf.String())
+	}
+}
+
+// TestFieldStringerConstructor verifies Stringer renders via String().
+func TestFieldStringerConstructor(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(WithOutput(&buf), WithLogFormat(LogFormatJSON))
+
+	log.InfoFields("parsed", Stringer("duration", 90*time.Second))
+
+	if !strings.Contains(buf.String(), `"duration":"1m30s"`) {
+		t.Errorf("output missing stringer field: %s", buf.String())
+	}
+}
@@ -9,12 +9,23 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
 )
 
+// defaultCallerSkip is the number of stack frames between runtime.Caller and
+// the application code that called Trace/Debug/Info/Warn/Error/Fatal/Panic.
+// It accounts for callerEvent itself and the ZeroLogger method that invokes
+// it; WithCaller/CallerSkipFrames add to this for packages that wrap
+// ZeroLogger behind their own logging helpers.
+const defaultCallerSkip = 2
+
 const (
 	// LogFormatJSON specifies JSON output format.
 	LogFormatJSON = "json"
@@ -49,6 +60,11 @@ var ErrInvalidLogFormat = errors.New("invalid log format")
 
 // Logger defines the interface for logging operations.
 type Logger interface {
+	// Trace and the rest of the ...any-based methods below are deprecated in
+	// favor of the typed Field-based TraceFields/DebugFields/... variants,
+	// which bind directly onto the underlying zerolog.Event instead of
+	// allocating a map[string]any per call. They remain for backward
+	// compatibility.
 	Trace(msg string, fields ...any)
 	Debug(msg string, fields ...any)
 	Info(msg string, fields ...any)
@@ -56,17 +72,43 @@ type Logger interface {
 	Error(err error, msg string, fields ...any)
 	Fatal(err error, msg string, fields ...any)
 	Panic(err error, msg string, fields ...any)
+	TraceFields(msg string, fields ...Field)
+	DebugFields(msg string, fields ...Field)
+	InfoFields(msg string, fields ...Field)
+	WarnFields(msg string, fields ...Field)
+	ErrorFields(err error, msg string, fields ...Field)
+	FatalFields(err error, msg string, fields ...Field)
+	PanicFields(err error, msg string, fields ...Field)
 	WithFields(fields ...any) Logger
 	WithContext(ctx context.Context) context.Context
 	FromContext(ctx context.Context) Logger
 	SetLevel(level string) error
 	SetLogFormat(format string) error
+	Name() string
+	CallerSkipFrames(n int) Logger
+	Unsampled() Logger
+	AddSink(s Sink)
+	RemoveSink(s Sink) bool
+	Close() error
 }
 
 // ZeroLogger is the zerolog implementation of the Logger interface.
+// It may be shared across goroutines: mu guards logger and config against
+// concurrent reads (Trace/Debug/.../WithFields) racing writes (SetLevel/
+// SetLogFormat).
 type ZeroLogger struct {
+	name string
+
+	mu     sync.RWMutex
 	logger zerolog.Logger
 	config *config
+
+	// sinks and defaultSink are shared by this logger and every logger
+	// derived from it (via WithFields, FromContext, CallerSkipFrames,
+	// Register), so AddSink/RemoveSink/SetLogFormat/SetLevel take effect
+	// across the whole family.
+	sinks       *multiSink
+	defaultSink *defaultSink
 }
 
 type config struct {
@@ -74,6 +116,16 @@ type config struct {
 	logFormat  string
 	timeFormat string
 	out        io.Writer
+
+	addCaller   bool
+	shortCaller bool
+	callerSkip  int
+
+	sinks []Sink
+
+	extractor func(context.Context) map[string]any
+
+	sampler Sampler
 }
 
 type option func(*config)
@@ -106,12 +158,49 @@ func WithOutput(w io.Writer) option {
 	}
 }
 
+// WithCaller sets the additional number of stack frames to skip, beyond
+// defaultCallerSkip, when resolving the caller (file:line) and func fields
+// attached to every log record. Packages that wrap this logger behind their
+// own helpers should pass the number of extra wrapper frames they add.
+func WithCaller(skip int) option {
+	return func(c *config) {
+		c.callerSkip = skip
+	}
+}
+
+// WithShortCaller trims the caller field to its base filename (e.g.
+// "logger.go:42") instead of the full path when enabled.
+func WithShortCaller(short bool) option {
+	return func(c *config) {
+		c.shortCaller = short
+	}
+}
+
+// WithAddCaller enables or disables the caller/func fields attached to every
+// log record. Caller enrichment is on by default; pass false to disable it,
+// e.g. for hot paths where the runtime.Caller lookup's cost isn't warranted.
+func WithAddCaller(enabled bool) option {
+	return func(c *config) {
+		c.addCaller = enabled
+	}
+}
+
+// WithSink adds an additional logging destination alongside the default one
+// configured via WithOutput/WithLogFormat (or the LOG_* env vars). May be
+// passed multiple times to fan out to several sinks at once.
+func WithSink(s Sink) option {
+	return func(c *config) {
+		c.sinks = append(c.sinks, s)
+	}
+}
+
 func defaults() *config {
 	return &config{
 		level:      LevelInfo,
 		logFormat:  LogFormatJSON,
 		timeFormat: time.RFC3339,
 		out:        os.Stdout,
+		addCaller:  true,
 	}
 }
 
@@ -140,87 +229,274 @@ func New(options ...option) Logger {
 	}
 
 	zerolog.TimeFieldFormat = config.timeFormat
+	logLevel, err := zerolog.ParseLevel(strings.ToLower(config.level))
+	if err != nil {
+		panic("invalid log level: " + err.Error())
+	}
+
 	outWriter, err := createWriter(config.logFormat, config.out, config.timeFormat)
 	if err != nil {
 		panic("failed to create log writer: " + err.Error())
 	}
 
-	logger := zerolog.New(outWriter).With().Timestamp().Logger()
-	logLevel, err := zerolog.ParseLevel(strings.ToLower(config.level))
-	if err != nil {
-		panic("invalid log level: " + err.Error())
+	ds := &defaultSink{level: logLevel, writer: outWriter}
+	ms := newMultiSink(append([]Sink{ds}, config.sinks...)...)
+
+	logger := zerolog.New(ms).With().Timestamp().Logger()
+	if config.sampler != nil {
+		logger = logger.Sample(config.sampler)
 	}
 
-	return &ZeroLogger{
-		logger: logger.Level(logLevel),
-		config: config,
+	zl := &ZeroLogger{
+		logger:      logger.Level(logLevel),
+		config:      config,
+		sinks:       ms,
+		defaultSink: ds,
 	}
+
+	defaultMu.Lock()
+	defaultLogger = zl
+	defaultMu.Unlock()
+
+	return zl
 }
 
 // Trace logs a trace-level message with optional fields.
+//
+// Deprecated: use TraceFields, which binds typed Fields directly onto the
+// zerolog.Event instead of allocating a map[string]any per call.
 func (z *ZeroLogger) Trace(msg string, fields ...any) {
-	z.logger.Trace().Fields(convertFields(fields...)).Msg(msg)
+	ev := z.snapshot().Trace()
+	z.callerEvent(ev)
+	ev.Fields(convertFields(fields...)).Msg(msg)
 }
 
 // Debug logs a debug-level message with optional fields.
+//
+// Deprecated: use DebugFields, which binds typed Fields directly onto the
+// zerolog.Event instead of allocating a map[string]any per call.
 func (z *ZeroLogger) Debug(msg string, fields ...any) {
-	z.logger.Debug().Fields(convertFields(fields...)).Msg(msg)
+	ev := z.snapshot().Debug()
+	z.callerEvent(ev)
+	ev.Fields(convertFields(fields...)).Msg(msg)
 }
 
 // Info logs an info-level message with optional fields.
+//
+// Deprecated: use InfoFields, which binds typed Fields directly onto the
+// zerolog.Event instead of allocating a map[string]any per call.
 func (z *ZeroLogger) Info(msg string, fields ...any) {
-	z.logger.Info().Fields(convertFields(fields...)).Msg(msg)
+	ev := z.snapshot().Info()
+	z.callerEvent(ev)
+	ev.Fields(convertFields(fields...)).Msg(msg)
 }
 
 // Warn logs a warn-level message with optional fields.
+//
+// Deprecated: use WarnFields, which binds typed Fields directly onto the
+// zerolog.Event instead of allocating a map[string]any per call.
 func (z *ZeroLogger) Warn(msg string, fields ...any) {
-	z.logger.Warn().Fields(convertFields(fields...)).Msg(msg)
+	ev := z.snapshot().Warn()
+	z.callerEvent(ev)
+	ev.Fields(convertFields(fields...)).Msg(msg)
 }
 
 // Error logs an error-level message with an error and optional fields.
+//
+// Deprecated: use ErrorFields, which binds typed Fields directly onto the
+// zerolog.Event instead of allocating a map[string]any per call.
 func (z *ZeroLogger) Error(err error, msg string, fields ...any) {
-	z.logger.Error().Err(err).Fields(convertFields(fields...)).Msg(msg)
+	ev := z.snapshot().Error().Err(err)
+	z.callerEvent(ev)
+	ev.Fields(convertFields(fields...)).Msg(msg)
 }
 
 // Fatal logs a fatal-level message with an error and optional fields, then exits the program.
+//
+// Deprecated: use FatalFields, which binds typed Fields directly onto the
+// zerolog.Event instead of allocating a map[string]any per call.
 func (z *ZeroLogger) Fatal(err error, msg string, fields ...any) {
-	z.logger.Fatal().Err(err).Fields(convertFields(fields...)).Msg(msg)
+	ev := z.snapshot().Fatal().Err(err)
+	z.callerEvent(ev)
+	ev.Fields(convertFields(fields...)).Msg(msg)
 }
 
 // Panic logs a panic-level message with an error and optional fields, then panics.
+//
+// Deprecated: use PanicFields, which binds typed Fields directly onto the
+// zerolog.Event instead of allocating a map[string]any per call.
 func (z *ZeroLogger) Panic(err error, msg string, fields ...any) {
-	z.logger.Panic().Err(err).Fields(convertFields(fields...)).Msg(msg)
+	ev := z.snapshot().Panic().Err(err)
+	z.callerEvent(ev)
+	ev.Fields(convertFields(fields...)).Msg(msg)
 }
 
-// WithFields returns a new logger with additional structured fields.
+// callerEvent resolves the log call site via runtime.Caller and attaches it
+// to ev as "caller" (file:line) and "func" fields, honoring config.addCaller,
+// config.shortCaller, and config.callerSkip.
+func (z *ZeroLogger) callerEvent(ev *zerolog.Event) {
+	z.mu.RLock()
+	cfg := *z.config
+	z.mu.RUnlock()
+
+	if !cfg.addCaller {
+		return
+	}
+
+	pc, file, line, ok := runtime.Caller(defaultCallerSkip + cfg.callerSkip)
+	if !ok {
+		return
+	}
+
+	if cfg.shortCaller {
+		file = path.Base(file)
+	}
+
+	ev.Str("caller", file+":"+strconv.Itoa(line))
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		ev.Str("func", fn.Name())
+	}
+}
+
+// snapshot returns the current zerolog.Logger under a read lock, so callers
+// don't hold z.mu while building and emitting the log event. It returns a
+// pointer to a local copy rather than z.logger itself, since zerolog's
+// Trace/Debug/.../WithContext methods have pointer receivers and a
+// by-value return wouldn't be addressable at the call site.
+func (z *ZeroLogger) snapshot() *zerolog.Logger {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+	l := z.logger
+	return &l
+}
+
+// WithFields returns a new logger with additional structured fields. The
+// child gets its own copy of the parent's config and zerolog.Logger, so
+// neither writes into the other's config struct. The sinks, including the
+// default sink, stay shared: SetLogFormat or SetLevel on either one still
+// changes the default sink's format or level for both.
 func (z *ZeroLogger) WithFields(fields ...any) Logger {
+	z.mu.RLock()
+	l := z.logger
+	cfg := *z.config
+	z.mu.RUnlock()
+
 	return &ZeroLogger{
-		logger: z.logger.With().Fields(convertFields(fields...)).Logger(),
-		config: z.config,
+		name:        z.name,
+		logger:      l.With().Fields(convertFields(fields...)).Logger(),
+		config:      &cfg,
+		sinks:       z.sinks,
+		defaultSink: z.defaultSink,
 	}
 }
 
 // WithContext attaches the logger to the provided context.
 func (z *ZeroLogger) WithContext(ctx context.Context) context.Context {
-	return z.logger.WithContext(ctx)
+	return z.snapshot().WithContext(ctx)
 }
 
-// FromContext retrieves the logger from the context. If none is found, returns a logger based on the current instance.
+// FromContext retrieves the logger attached to ctx via WithContext, falling
+// back to the current instance if none is found. Either way, any fields
+// attached to ctx via ContextWithFields, plus anything a WithContextExtractor
+// pulls out of ctx, are merged on top rather than discarding the base
+// logger's own fields.
 func (z *ZeroLogger) FromContext(ctx context.Context) Logger {
 	logger := zerolog.Ctx(ctx)
-	if logger.GetLevel() == zerolog.Disabled {
-		return &ZeroLogger{
-			logger: z.logger,
-			config: z.config,
+
+	z.mu.RLock()
+	parent := z.logger
+	cfg := *z.config
+	z.mu.RUnlock()
+
+	base := parent
+	if logger.GetLevel() != zerolog.Disabled {
+		base = *logger
+	}
+
+	fields := FieldsFromContext(ctx)
+	if cfg.extractor != nil {
+		for k, v := range cfg.extractor(ctx) {
+			fields[k] = v
 		}
 	}
 
+	if len(fields) > 0 {
+		base = base.With().Fields(fields).Logger()
+	}
+
 	return &ZeroLogger{
-		logger: *logger,
-		config: z.config,
+		name:        z.name,
+		logger:      base,
+		config:      &cfg,
+		sinks:       z.sinks,
+		defaultSink: z.defaultSink,
 	}
 }
 
+// Name returns the name the logger was registered under via Register, or an
+// empty string for loggers created directly with New.
+func (z *ZeroLogger) Name() string {
+	return z.name
+}
+
+// CallerSkipFrames returns a logger that skips n additional stack frames,
+// beyond whatever is already configured, when resolving the caller field.
+// Packages that wrap this logger behind their own logging helpers should
+// call this once with the number of wrapper frames they add.
+func (z *ZeroLogger) CallerSkipFrames(n int) Logger {
+	z.mu.RLock()
+	l := z.logger
+	cfg := *z.config
+	z.mu.RUnlock()
+
+	cfg.callerSkip += n
+
+	return &ZeroLogger{
+		name:        z.name,
+		logger:      l,
+		config:      &cfg,
+		sinks:       z.sinks,
+		defaultSink: z.defaultSink,
+	}
+}
+
+// Unsampled returns a logger that bypasses any Sampler configured via
+// WithSampler, for critical events that must never be dropped.
+func (z *ZeroLogger) Unsampled() Logger {
+	z.mu.RLock()
+	l := z.logger
+	cfg := *z.config
+	z.mu.RUnlock()
+
+	return &ZeroLogger{
+		name:        z.name,
+		logger:      l.Sample(nil),
+		config:      &cfg,
+		sinks:       z.sinks,
+		defaultSink: z.defaultSink,
+	}
+}
+
+// AddSink registers an additional sink to receive every log record emitted
+// by this logger and any logger derived from it (WithFields, FromContext,
+// CallerSkipFrames, Register).
+func (z *ZeroLogger) AddSink(s Sink) {
+	z.sinks.add(s)
+}
+
+// RemoveSink unregisters a sink previously added with AddSink, reporting
+// whether it was found.
+func (z *ZeroLogger) RemoveSink(s Sink) bool {
+	return z.sinks.remove(s)
+}
+
+// Close closes every sink registered on this logger, including any added at
+// runtime via AddSink. An AsyncSink drains its buffer within its configured
+// shutdown timeout before closing.
+func (z *ZeroLogger) Close() error {
+	return z.sinks.close()
+}
+
 // SetLevel sets the minimum log level for the logger.
 func (z *ZeroLogger) SetLevel(level string) error {
 	logLevel, err := zerolog.ParseLevel(strings.ToLower(level))
@@ -228,19 +504,28 @@ func (z *ZeroLogger) SetLevel(level string) error {
 		return ErrInvalidLogLevel
 	}
 
+	z.mu.Lock()
 	z.logger = z.logger.Level(logLevel)
+	z.mu.Unlock()
+
+	z.defaultSink.setLevel(logLevel)
 	return nil
 }
 
-// SetLogFormat sets the output format (json or console) for the logger.
+// SetLogFormat sets the output format (json or console) for the logger's
+// default sink, leaving any sinks added via WithSink/AddSink untouched.
 func (z *ZeroLogger) SetLogFormat(format string) error {
+	z.mu.Lock()
 	outWriter, err := createWriter(format, z.config.out, z.config.timeFormat)
 	if err != nil {
+		z.mu.Unlock()
 		return err
 	}
 
-	z.logger = z.logger.Output(outWriter)
 	z.config.logFormat = format
+	z.mu.Unlock()
+
+	z.defaultSink.setWriter(outWriter)
 	return nil
 }
 
@@ -262,6 +547,8 @@ func createWriter(format string, out io.Writer, timeFormat string) (io.Writer, e
 }
 
 // convertFields converts key-value pairs to a map, skipping invalid pairs.
+// It backs the deprecated ...any logging methods; prefer the typed Field
+// constructors and the *Fields methods, which avoid this allocation.
 func convertFields(fields ...any) map[string]any {
 	fieldMap := make(map[string]any, len(fields)/2)
 	for i := 0; i < len(fields); i += 2 {
@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentLoggingAndReconfiguration exercises Info/SetLevel/SetLogFormat
+// from many goroutines at once. Run with -race to catch data races on the
+// shared ZeroLogger.
+func TestConcurrentLoggingAndReconfiguration(t *testing.T) {
+	log := New(WithOutput(io.Discard))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(3)
+
+		go func(i int) {
+			defer wg.Done()
+			log.Info("concurrent info", "i", i)
+		}(i)
+
+		go func() {
+			defer wg.Done()
+			if err := log.SetLevel(LevelDebug); err != nil {
+				t.Errorf("SetLevel: %v", err)
+			}
+		}()
+
+		go func(i int) {
+			defer wg.Done()
+			format := LogFormatJSON
+			if i%2 == 0 {
+				format = LogFormatConsole
+			}
+
+			if err := log.SetLogFormat(format); err != nil {
+				t.Errorf("SetLogFormat: %v", err)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+// TestWithFieldsDoesNotAliasParentConfig verifies that children spawned by
+// WithFields get their own config, so a child's SetLogFormat never rewrites
+// the parent's config.logFormat.
+func TestWithFieldsDoesNotAliasParentConfig(t *testing.T) {
+	parent := New(WithOutput(io.Discard), WithLogFormat(LogFormatJSON))
+
+	var wg sync.WaitGroup
+	children := make([]Logger, 20)
+	for i := range children {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			children[i] = parent.WithFields("worker", i)
+			_ = children[i].SetLogFormat(LogFormatConsole)
+		}(i)
+	}
+	wg.Wait()
+
+	zp, ok := parent.(*ZeroLogger)
+	if !ok {
+		t.Fatalf("parent is not *ZeroLogger")
+	}
+
+	if zp.config.logFormat != LogFormatJSON {
+		t.Errorf("parent config.logFormat = %q, want %q (child mutation leaked into parent)", zp.config.logFormat, LogFormatJSON)
+	}
+}
+
+// TestFromContextConcurrent exercises WithContext/FromContext alongside
+// concurrent reconfiguration of the originating logger.
+func TestFromContextConcurrent(t *testing.T) {
+	log := New(WithOutput(io.Discard))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			ctx := log.WithContext(context.Background())
+			log.FromContext(ctx).Info("from context")
+		}()
+
+		go func() {
+			defer wg.Done()
+			_ = log.SetLevel(LevelInfo)
+		}()
+	}
+
+	wg.Wait()
+}
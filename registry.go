@@ -0,0 +1,185 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// ErrPackageNotRegistered is returned when operating on a package name that
+// has not been registered via Register.
+var ErrPackageNotRegistered = errors.New("package not registered")
+
+var (
+	defaultMu     sync.RWMutex
+	defaultLogger *ZeroLogger
+
+	registryMu sync.RWMutex
+	registry   = make(map[string]*ZeroLogger)
+)
+
+// rootLogger returns the shared root logger that package loggers derive
+// their output, format, and time settings from. If New has never been
+// called, a default logger is lazily created so Register still works.
+func rootLogger() *ZeroLogger {
+	defaultMu.RLock()
+	root := defaultLogger
+	defaultMu.RUnlock()
+	if root != nil {
+		return root
+	}
+
+	// New records the logger it creates as defaultLogger under defaultMu, so
+	// it must be called without holding that lock.
+	New()
+
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultLogger
+}
+
+// Register creates a named sub-logger (typically one per Go package or
+// component) and records it in a package-level registry so its level can be
+// tuned independently at runtime via SetPackageLevel. The returned logger
+// inherits its output, format, and time settings from the shared root
+// logger, and automatically carries a "component" field set to name. Passing
+// WithSink adds that sink to the root's shared multiSink (the same one
+// AddSink would), so it's visible to every logger in the family rather than
+// just the one returned here.
+func Register(name string, opts ...option) Logger {
+	root := rootLogger()
+
+	root.mu.RLock()
+	parent := root.logger
+	cfg := *root.config
+	root.mu.RUnlock()
+
+	// Defensively copy so appending to cfg.sinks below (via WithSink) can't
+	// alias onto root.config.sinks's backing array, and so baseSinkCount
+	// reliably marks where this call's own sinks start.
+	cfg.sinks = append([]Sink(nil), cfg.sinks...)
+	baseSinkCount := len(cfg.sinks)
+
+	rootLevel := cfg.level
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	for _, s := range cfg.sinks[baseSinkCount:] {
+		root.sinks.add(s)
+	}
+
+	logLevel := parent.GetLevel()
+	if cfg.level != rootLevel {
+		if parsed, err := zerolog.ParseLevel(strings.ToLower(cfg.level)); err == nil {
+			logLevel = parsed
+		}
+	}
+
+	zl := &ZeroLogger{
+		name:        name,
+		logger:      parent.With().Str("component", name).Logger().Level(logLevel),
+		config:      &cfg,
+		sinks:       root.sinks,
+		defaultSink: root.defaultSink,
+	}
+
+	registryMu.Lock()
+	registry[name] = zl
+	registryMu.Unlock()
+
+	lowerDefaultSinkLevel()
+	return zl
+}
+
+// SetPackageLevel sets the minimum log level for a single registered package
+// logger, leaving every other registered logger untouched.
+func SetPackageLevel(name, level string) error {
+	logLevel, err := zerolog.ParseLevel(strings.ToLower(level))
+	if err != nil {
+		return ErrInvalidLogLevel
+	}
+
+	registryMu.RLock()
+	zl, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrPackageNotRegistered, name)
+	}
+
+	zl.mu.Lock()
+	zl.logger = zl.logger.Level(logLevel)
+	zl.config.level = level
+	zl.mu.Unlock()
+
+	lowerDefaultSinkLevel()
+	return nil
+}
+
+// SetAllLevels sets the minimum log level for every registered package
+// logger, letting operators reset verbosity across the board in one call.
+func SetAllLevels(level string) error {
+	logLevel, err := zerolog.ParseLevel(strings.ToLower(level))
+	if err != nil {
+		return ErrInvalidLogLevel
+	}
+
+	registryMu.RLock()
+	for _, zl := range registry {
+		zl.mu.Lock()
+		zl.logger = zl.logger.Level(logLevel)
+		zl.config.level = level
+		zl.mu.Unlock()
+	}
+	registryMu.RUnlock()
+
+	lowerDefaultSinkLevel()
+	return nil
+}
+
+// lowerDefaultSinkLevel recomputes the shared default sink's level as the
+// lowest (most verbose) level among the root logger and every registered
+// package logger. Registered loggers share the root's defaultSink (see
+// Register), so without this, lowering a single package's own level (say,
+// to debug) via SetPackageLevel would have no visible effect: the sink's filter, fixed at
+// the root's level, would still drop the records before they reached the
+// writer.
+func lowerDefaultSinkLevel() {
+	root := rootLogger()
+
+	root.mu.RLock()
+	min := root.logger.GetLevel()
+	ds := root.defaultSink
+	root.mu.RUnlock()
+
+	registryMu.RLock()
+	for _, zl := range registry {
+		zl.mu.RLock()
+		if l := zl.logger.GetLevel(); l < min {
+			min = l
+		}
+		zl.mu.RUnlock()
+	}
+	registryMu.RUnlock()
+
+	ds.setLevel(min)
+}
+
+// Levels returns the current log level of every registered package logger,
+// keyed by the name passed to Register.
+func Levels() map[string]string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	levels := make(map[string]string, len(registry))
+	for name, zl := range registry {
+		zl.mu.RLock()
+		levels[name] = zl.logger.GetLevel().String()
+		zl.mu.RUnlock()
+	}
+
+	return levels
+}
@@ -0,0 +1,115 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// TestRegisterInheritsRootOutputAndSetsComponent verifies that a registered
+// logger writes through the root's output/format and carries a "component"
+// field set to its registered name.
+func TestRegisterInheritsRootOutputAndSetsComponent(t *testing.T) {
+	var buf bytes.Buffer
+	New(WithOutput(&buf), WithLogFormat(LogFormatJSON), WithLevel(LevelInfo))
+
+	pkgLog := Register("registry_test_pkg_a")
+	pkgLog.Info("hello")
+
+	out := buf.String()
+	if !strings.Contains(out, `"component":"registry_test_pkg_a"`) {
+		t.Errorf("output missing component field: %s", out)
+	}
+}
+
+// TestSetPackageLevelRaisesVerbosity verifies that lowering a single
+// registered package's level via SetPackageLevel actually lets records at
+// that level reach the output, rather than being dropped by the shared
+// default sink.
+func TestSetPackageLevelRaisesVerbosity(t *testing.T) {
+	var buf bytes.Buffer
+	New(WithOutput(&buf), WithLogFormat(LogFormatJSON), WithLevel(LevelInfo))
+
+	pkgLog := Register("registry_test_pkg_b")
+	if err := SetPackageLevel("registry_test_pkg_b", LevelDebug); err != nil {
+		t.Fatalf("SetPackageLevel: %v", err)
+	}
+
+	pkgLog.Debug("debug message")
+
+	if !strings.Contains(buf.String(), "debug message") {
+		t.Errorf("debug message did not reach output after SetPackageLevel; got %q", buf.String())
+	}
+}
+
+// TestSetPackageLevelUnknownPackage verifies the documented error for a name
+// that was never passed to Register.
+func TestSetPackageLevelUnknownPackage(t *testing.T) {
+	if err := SetPackageLevel("registry_test_pkg_does_not_exist", LevelDebug); !errors.Is(err, ErrPackageNotRegistered) {
+		t.Errorf("SetPackageLevel on unknown package = %v, want ErrPackageNotRegistered", err)
+	}
+}
+
+// TestRegisterWithSinkReachesSharedMultiSink verifies a sink passed to
+// Register via WithSink is added to the root's shared multiSink, rather than
+// being silently dropped, so every logger in the family fans out to it.
+func TestRegisterWithSinkReachesSharedMultiSink(t *testing.T) {
+	New(WithOutput(io.Discard))
+
+	extra := &recordingSink{level: zerolog.InfoLevel}
+	pkgLog := Register("registry_test_pkg_d", WithSink(extra))
+	pkgLog.Info("hello")
+
+	if got := extra.count(); got != 1 {
+		t.Errorf("extra sink received %d records, want 1", got)
+	}
+}
+
+// TestSetAllLevels verifies SetAllLevels changes every registered logger's
+// level, and that the shared sink's effective level is lowered to match.
+func TestSetAllLevels(t *testing.T) {
+	var buf bytes.Buffer
+	New(WithOutput(&buf), WithLogFormat(LogFormatJSON), WithLevel(LevelInfo))
+
+	pkgA := Register("registry_test_pkg_all_a")
+	pkgB := Register("registry_test_pkg_all_b")
+
+	if err := SetAllLevels(LevelDebug); err != nil {
+		t.Fatalf("SetAllLevels: %v", err)
+	}
+
+	levels := Levels()
+	if levels["registry_test_pkg_all_a"] != LevelDebug {
+		t.Errorf("Levels()[registry_test_pkg_all_a] = %q, want %q", levels["registry_test_pkg_all_a"], LevelDebug)
+	}
+	if levels["registry_test_pkg_all_b"] != LevelDebug {
+		t.Errorf("Levels()[registry_test_pkg_all_b] = %q, want %q", levels["registry_test_pkg_all_b"], LevelDebug)
+	}
+
+	pkgA.Debug("debug from a")
+	pkgB.Debug("debug from b")
+
+	out := buf.String()
+	if !strings.Contains(out, "debug from a") {
+		t.Errorf("debug message from pkgA did not reach output after SetAllLevels; got %q", out)
+	}
+	if !strings.Contains(out, "debug from b") {
+		t.Errorf("debug message from pkgB did not reach output after SetAllLevels; got %q", out)
+	}
+}
+
+// TestLevels verifies Levels reports the level a registered logger was
+// created with.
+func TestLevels(t *testing.T) {
+	New(WithOutput(io.Discard))
+	Register("registry_test_pkg_c", WithLevel(LevelWarn))
+
+	levels := Levels()
+	if got := levels["registry_test_pkg_c"]; got != LevelWarn {
+		t.Errorf("Levels()[registry_test_pkg_c] = %q, want %q", got, LevelWarn)
+	}
+}
@@ -0,0 +1,29 @@
+package logger
+
+import "github.com/rs/zerolog"
+
+// Sampler decides whether a log event at a given level should be emitted.
+// It is satisfied by zerolog.Sampler, so the built-ins below, or any custom
+// implementation, can be passed directly to WithSampler.
+type Sampler = zerolog.Sampler
+
+// BasicSampler samples 1 event out of every N, dropping the rest.
+type BasicSampler = zerolog.BasicSampler
+
+// BurstSampler allows a burst of Burst events per Period before falling
+// back to NextSampler, which may be nil to drop everything past the burst.
+type BurstSampler = zerolog.BurstSampler
+
+// LevelSampler applies a different Sampler per level, the common pattern
+// being to sample Debug/Info aggressively while leaving Warn/Error
+// unsampled.
+type LevelSampler = zerolog.LevelSampler
+
+// WithSampler enables sampling on the logger, using s to decide, per event,
+// whether it is emitted. Combine with LevelSampler to vary the rate by
+// level, and see Unsampled for a per-call escape hatch.
+func WithSampler(s Sampler) option {
+	return func(c *config) {
+		c.sampler = s
+	}
+}
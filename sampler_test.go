@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestWithSamplerDropsSampledEvents verifies a BasicSampler configured via
+// WithSampler actually suppresses most events at the sampled rate.
+func TestWithSamplerDropsSampledEvents(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(
+		WithOutput(&buf),
+		WithLogFormat(LogFormatJSON),
+		WithSampler(&BasicSampler{N: 100}),
+	)
+
+	for i := 0; i < 10; i++ {
+		log.Info("high volume event")
+	}
+
+	lines := strings.Count(buf.String(), "\n")
+	if lines >= 10 {
+		t.Errorf("got %d lines out of 10 events with a 1-in-100 sampler, want fewer", lines)
+	}
+}
+
+// TestUnsampledBypassesSampler verifies Unsampled emits every event even
+// when the parent logger has a sampler configured.
+func TestUnsampledBypassesSampler(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(
+		WithOutput(&buf),
+		WithLogFormat(LogFormatJSON),
+		WithSampler(&BasicSampler{N: 100}),
+	)
+
+	unsampled := log.Unsampled()
+	for i := 0; i < 10; i++ {
+		unsampled.Info("critical event")
+	}
+
+	lines := strings.Count(buf.String(), "\n")
+	if lines != 10 {
+		t.Errorf("got %d lines out of 10 events via Unsampled, want 10", lines)
+	}
+}
+
+// TestLevelSamplerVariesByLevel verifies LevelSampler can sample one level
+// aggressively while leaving another unsampled.
+func TestLevelSamplerVariesByLevel(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(
+		WithOutput(&buf),
+		WithLogFormat(LogFormatJSON),
+		WithLevel(LevelDebug),
+		WithSampler(&LevelSampler{DebugSampler: &BasicSampler{N: 100}}),
+	)
+
+	for i := 0; i < 10; i++ {
+		log.Debug("debug event")
+	}
+	for i := 0; i < 10; i++ {
+		log.Warn("warn event")
+	}
+
+	out := buf.String()
+	if strings.Count(out, "warn event") != 10 {
+		t.Errorf("got %d warn lines, want 10 (unsampled level)", strings.Count(out, "warn event"))
+	}
+	if strings.Count(out, "debug event") >= 10 {
+		t.Errorf("got %d debug lines out of 10 with a 1-in-100 sampler, want fewer", strings.Count(out, "debug event"))
+	}
+}
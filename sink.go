@@ -0,0 +1,350 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// ErrAsyncSinkFull is returned by AsyncSink.Write when its buffer is full
+// and the record had to be dropped rather than block the caller.
+var ErrAsyncSinkFull = errors.New("logger: async sink buffer full")
+
+// ErrAsyncSinkClosed is returned by AsyncSink.Write once Shutdown/Close has
+// been called, instead of sending on the now-closed entries channel.
+var ErrAsyncSinkClosed = errors.New("logger: async sink closed")
+
+// Sink is a single logging destination. A ZeroLogger can fan a single
+// stream of log records out to many sinks (console, file, syslog, Kafka,
+// HTTP, ...) at once, each filtering by its own minimum level.
+type Sink interface {
+	// Write emits p. The logger's multiSink calls it only when level meets
+	// or exceeds Level(), or for every record when level is
+	// zerolog.NoLevel; the built-in sinks do no level filtering of their own.
+	Write(level zerolog.Level, p []byte) error
+	// Level reports the minimum level this sink accepts.
+	Level() zerolog.Level
+	// Close releases any resources held by the sink.
+	Close() error
+}
+
+// multiSink fans writes out to every registered Sink and implements
+// zerolog.LevelWriter so zerolog passes the event's level directly instead
+// of it having to be inferred from the encoded payload.
+type multiSink struct {
+	mu    sync.RWMutex
+	sinks []Sink
+}
+
+func newMultiSink(sinks ...Sink) *multiSink {
+	return &multiSink{sinks: append([]Sink(nil), sinks...)}
+}
+
+// Write implements io.Writer for callers that bypass zerolog's level-aware
+// path; it fans out to every sink regardless of level.
+func (m *multiSink) Write(p []byte) (int, error) {
+	return m.WriteLevel(zerolog.NoLevel, p)
+}
+
+// WriteLevel implements zerolog.LevelWriter.
+func (m *multiSink) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var firstErr error
+	for _, s := range m.sinks {
+		if level != zerolog.NoLevel && level < s.Level() {
+			continue
+		}
+
+		if err := s.Write(level, p); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return len(p), firstErr
+}
+
+func (m *multiSink) add(s Sink) {
+	m.mu.Lock()
+	m.sinks = append(m.sinks, s)
+	m.mu.Unlock()
+}
+
+func (m *multiSink) remove(s Sink) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, existing := range m.sinks {
+		if existing == s {
+			m.sinks = append(m.sinks[:i], m.sinks[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *multiSink) close() error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var firstErr error
+	for _, s := range m.sinks {
+		if err := s.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// defaultSink adapts the logger's primary out/format/timeFormat
+// configuration (as set via WithOutput/WithLogFormat or the LOG_* env vars)
+// into a Sink, so it can be combined with any sinks added via WithSink.
+type defaultSink struct {
+	mu     sync.RWMutex
+	level  zerolog.Level
+	writer io.Writer
+}
+
+func (s *defaultSink) Write(_ zerolog.Level, p []byte) error {
+	s.mu.RLock()
+	w := s.writer
+	s.mu.RUnlock()
+
+	_, err := w.Write(p)
+	return err
+}
+
+func (s *defaultSink) Level() zerolog.Level {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.level
+}
+
+func (s *defaultSink) Close() error { return nil }
+
+func (s *defaultSink) setWriter(w io.Writer) {
+	s.mu.Lock()
+	s.writer = w
+	s.mu.Unlock()
+}
+
+func (s *defaultSink) setLevel(level zerolog.Level) {
+	s.mu.Lock()
+	s.level = level
+	s.mu.Unlock()
+}
+
+// ConsoleSink writes pretty-printed, human-readable log lines to an
+// io.Writer (e.g. os.Stdout), honoring NO_COLOR.
+type ConsoleSink struct {
+	level  zerolog.Level
+	writer zerolog.ConsoleWriter
+}
+
+// NewConsoleSink creates a ConsoleSink accepting level and above, formatting
+// timestamps with timeFormat.
+func NewConsoleSink(w io.Writer, level zerolog.Level, timeFormat string) *ConsoleSink {
+	cw := zerolog.ConsoleWriter{Out: w, TimeFormat: timeFormat}
+	if os.Getenv("NO_COLOR") != "" {
+		cw.NoColor = true
+	}
+
+	return &ConsoleSink{level: level, writer: cw}
+}
+
+// Write implements Sink.
+func (s *ConsoleSink) Write(_ zerolog.Level, p []byte) error {
+	_, err := s.writer.Write(p)
+	return err
+}
+
+// Level implements Sink.
+func (s *ConsoleSink) Level() zerolog.Level { return s.level }
+
+// Close implements Sink. ConsoleSink holds no resources of its own.
+func (s *ConsoleSink) Close() error { return nil }
+
+// RotateFunc decides whether a FileSink should rotate its underlying file
+// before the next write, given the file's current size in bytes.
+type RotateFunc func(size int64) bool
+
+// FileSink writes JSON log lines to a file, with an optional RotateFunc hook
+// consulted before every write so callers can plug in their own rotation
+// policy (size, age, external signal, ...).
+type FileSink struct {
+	mu     sync.Mutex
+	level  zerolog.Level
+	path   string
+	file   *os.File
+	rotate RotateFunc
+}
+
+// NewFileSink opens (or creates) path for appending and returns a FileSink
+// accepting level and above. rotate may be nil to disable rotation.
+func NewFileSink(path string, level zerolog.Level, rotate RotateFunc) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{level: level, path: path, file: f, rotate: rotate}, nil
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(_ zerolog.Level, p []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.rotate != nil {
+		if info, err := s.file.Stat(); err == nil && s.rotate(info.Size()) {
+			if err := s.rotateLocked(); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := s.file.Write(p)
+	return err
+}
+
+func (s *FileSink) rotateLocked() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	rotated := s.path + "." + time.Now().UTC().Format("20060102T150405")
+	if err := os.Rename(s.path, rotated); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	s.file = f
+	return nil
+}
+
+// Level implements Sink.
+func (s *FileSink) Level() zerolog.Level { return s.level }
+
+// Close implements Sink, closing the underlying file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+type asyncEntry struct {
+	level zerolog.Level
+	p     []byte
+}
+
+// AsyncSink wraps another Sink with a buffered channel and a background
+// goroutine, decoupling slow downstream sinks (network, disk) from the
+// calling goroutine's log call. Writes past the buffer's capacity are
+// dropped, returning ErrAsyncSinkFull, rather than blocking the logger.
+// Writes that race a Shutdown/Close return ErrAsyncSinkClosed instead of
+// sending on the closed entries channel.
+type AsyncSink struct {
+	target  Sink
+	entries chan asyncEntry
+	done    chan struct{}
+	timeout time.Duration
+
+	// mu guards closed against Write racing Shutdown's close(a.entries):
+	// Write holds a read lock across its send, so Shutdown's write lock
+	// can't flip closed and close the channel while a send is in flight.
+	mu           sync.RWMutex
+	closed       bool
+	shutdownOnce sync.Once
+	shutdownErr  error
+}
+
+// NewAsyncSink wraps target with a buffered channel of the given size and
+// starts the background dispatch goroutine. shutdownTimeout bounds how long
+// Shutdown (and Close) wait for the buffer to drain.
+func NewAsyncSink(target Sink, bufferSize int, shutdownTimeout time.Duration) *AsyncSink {
+	a := &AsyncSink{
+		target:  target,
+		entries: make(chan asyncEntry, bufferSize),
+		done:    make(chan struct{}),
+		timeout: shutdownTimeout,
+	}
+
+	go a.run()
+	return a
+}
+
+func (a *AsyncSink) run() {
+	defer close(a.done)
+	for e := range a.entries {
+		_ = a.target.Write(e.level, e.p)
+	}
+}
+
+// Write implements Sink, enqueuing p for the background goroutine.
+func (a *AsyncSink) Write(level zerolog.Level, p []byte) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.closed {
+		return ErrAsyncSinkClosed
+	}
+
+	cp := append([]byte(nil), p...)
+
+	select {
+	case a.entries <- asyncEntry{level: level, p: cp}:
+		return nil
+	default:
+		return ErrAsyncSinkFull
+	}
+}
+
+// Level implements Sink, delegating to the wrapped target.
+func (a *AsyncSink) Level() zerolog.Level { return a.target.Level() }
+
+// Close stops accepting new records and waits for the buffer to drain,
+// bounded by the shutdown timeout passed to NewAsyncSink. Prefer Shutdown
+// when a context is already available.
+func (a *AsyncSink) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+	defer cancel()
+	return a.Shutdown(ctx)
+}
+
+// Shutdown stops accepting new records and waits for the buffer to drain or
+// ctx to expire, whichever comes first. If the buffer drained, it closes the
+// wrapped target; if ctx expired first, it returns ctx's error and leaves the
+// target open.
+// Shutdown (and Close, which calls it) is idempotent: only the first call
+// actually closes anything, since every logger in a Register-derived family
+// shares the same sink and could plausibly call Close independently.
+func (a *AsyncSink) Shutdown(ctx context.Context) error {
+	a.shutdownOnce.Do(func() {
+		a.mu.Lock()
+		a.closed = true
+		close(a.entries)
+		a.mu.Unlock()
+
+		select {
+		case <-a.done:
+			a.shutdownErr = a.target.Close()
+		case <-ctx.Done():
+			a.shutdownErr = fmt.Errorf("async sink shutdown: %w", ctx.Err())
+		}
+	})
+
+	return a.shutdownErr
+}
@@ -0,0 +1,218 @@
+package logger
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// recordingSink collects every record it receives, for assertions.
+type recordingSink struct {
+	mu     sync.Mutex
+	level  zerolog.Level
+	writes [][]byte
+}
+
+func (s *recordingSink) Write(_ zerolog.Level, p []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writes = append(s.writes, append([]byte(nil), p...))
+	return nil
+}
+
+func (s *recordingSink) Level() zerolog.Level { return s.level }
+func (s *recordingSink) Close() error         { return nil }
+
+func (s *recordingSink) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.writes)
+}
+
+// TestMultiSinkFiltersByLevel verifies each sink only receives records at or
+// above its own Level, independent of the others.
+func TestMultiSinkFiltersByLevel(t *testing.T) {
+	debugSink := &recordingSink{level: zerolog.DebugLevel}
+	errorSink := &recordingSink{level: zerolog.ErrorLevel}
+	ms := newMultiSink(debugSink, errorSink)
+
+	ms.WriteLevel(zerolog.DebugLevel, []byte("debug line"))
+	ms.WriteLevel(zerolog.InfoLevel, []byte("info line"))
+	ms.WriteLevel(zerolog.ErrorLevel, []byte("error line"))
+
+	if got := debugSink.count(); got != 3 {
+		t.Errorf("debugSink received %d records, want 3", got)
+	}
+
+	if got := errorSink.count(); got != 1 {
+		t.Errorf("errorSink received %d records, want 1", got)
+	}
+}
+
+// TestMultiSinkAddRemove verifies a sink stops receiving records once
+// removed.
+func TestMultiSinkAddRemove(t *testing.T) {
+	s := &recordingSink{level: zerolog.InfoLevel}
+	ms := newMultiSink()
+	ms.add(s)
+
+	ms.WriteLevel(zerolog.InfoLevel, []byte("one"))
+	if !ms.remove(s) {
+		t.Fatalf("remove reported sink not found")
+	}
+
+	ms.WriteLevel(zerolog.InfoLevel, []byte("two"))
+	if got := s.count(); got != 1 {
+		t.Errorf("sink received %d records after removal, want 1", got)
+	}
+}
+
+// TestFileSinkRotates verifies a FileSink renames its current file aside and
+// opens a fresh one when RotateFunc reports it should.
+func TestFileSinkRotates(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.log")
+
+	rotateNext := false
+	sink, err := NewFileSink(path, zerolog.InfoLevel, func(size int64) bool {
+		return rotateNext
+	})
+	if err != nil {
+		t.Fatalf("NewFileSink: %v", err)
+	}
+	defer sink.Close()
+
+	if err := sink.Write(zerolog.InfoLevel, []byte("first\n")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	rotateNext = true
+	if err := sink.Write(zerolog.InfoLevel, []byte("second\n")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("dir contains %d entries, want 2 (rotated + current)", len(entries))
+	}
+}
+
+// TestAsyncSinkDropsWhenFull verifies Write returns ErrAsyncSinkFull once the
+// buffer is saturated, instead of blocking the caller.
+func TestAsyncSinkDropsWhenFull(t *testing.T) {
+	block := make(chan struct{})
+	target := &blockingSink{block: block}
+
+	a := NewAsyncSink(target, 1, time.Second)
+	defer func() {
+		close(block)
+		a.Close()
+	}()
+
+	// The first entry is immediately picked up by the background goroutine
+	// and blocks there on target.Write, freeing the buffer slot back up.
+	if err := a.Write(zerolog.InfoLevel, []byte("first")); err != nil {
+		t.Fatalf("first Write: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	if err := a.Write(zerolog.InfoLevel, []byte("second")); err != nil {
+		t.Fatalf("second Write: %v", err)
+	}
+
+	if err := a.Write(zerolog.InfoLevel, []byte("third")); err != ErrAsyncSinkFull {
+		t.Errorf("third Write = %v, want ErrAsyncSinkFull", err)
+	}
+}
+
+// blockingSink blocks every Write until block is closed, letting tests force
+// AsyncSink's buffer to fill.
+type blockingSink struct {
+	block chan struct{}
+}
+
+func (s *blockingSink) Write(_ zerolog.Level, _ []byte) error {
+	<-s.block
+	return nil
+}
+
+func (s *blockingSink) Level() zerolog.Level { return zerolog.NoLevel }
+func (s *blockingSink) Close() error         { return nil }
+
+// TestAsyncSinkShutdownDrains verifies Shutdown waits for buffered records to
+// reach the wrapped target before returning.
+func TestAsyncSinkShutdownDrains(t *testing.T) {
+	target := &recordingSink{level: zerolog.NoLevel}
+	a := NewAsyncSink(target, 10, time.Second)
+
+	for i := 0; i < 5; i++ {
+		if err := a.Write(zerolog.InfoLevel, []byte("entry")); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+
+	if err := a.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	if got := target.count(); got != 5 {
+		t.Errorf("target received %d records after Shutdown, want 5", got)
+	}
+}
+
+// TestAsyncSinkWriteDuringShutdownDoesNotPanic drives Write concurrently with
+// Shutdown to catch a send on the closed entries channel, which previously
+// panicked regardless of the select/default in Write.
+func TestAsyncSinkWriteDuringShutdownDoesNotPanic(t *testing.T) {
+	target := &recordingSink{level: zerolog.NoLevel}
+	a := NewAsyncSink(target, 4, time.Second)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			a.Write(zerolog.InfoLevel, []byte("entry"))
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		a.Shutdown(context.Background())
+	}()
+
+	wg.Wait()
+
+	if err := a.Write(zerolog.InfoLevel, []byte("after shutdown")); err != ErrAsyncSinkClosed {
+		t.Errorf("Write after Shutdown = %v, want ErrAsyncSinkClosed", err)
+	}
+}
+
+// TestAsyncSinkShutdownIdempotent verifies calling Shutdown/Close more than
+// once never panics (close of closed channel) and returns the same result.
+func TestAsyncSinkShutdownIdempotent(t *testing.T) {
+	target := &recordingSink{level: zerolog.NoLevel}
+	a := NewAsyncSink(target, 4, time.Second)
+
+	if err := a.Shutdown(context.Background()); err != nil {
+		t.Fatalf("first Shutdown: %v", err)
+	}
+
+	if err := a.Shutdown(context.Background()); err != nil {
+		t.Errorf("second Shutdown: %v", err)
+	}
+
+	if err := a.Close(); err != nil {
+		t.Errorf("Close after Shutdown: %v", err)
+	}
+}